@@ -5,10 +5,13 @@ package datapipeline
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/datapipeline"
@@ -29,14 +32,34 @@ func ResourcePipelineDefinition() *schema.Resource {
 		CreateWithoutTimeout: resourcePipelineDefinitionPut,
 		ReadWithoutTimeout:   resourcePipelineDefinitionRead,
 		UpdateWithoutTimeout: resourcePipelineDefinitionPut,
-		DeleteWithoutTimeout: schema.NoopContext,
+		DeleteWithoutTimeout: resourcePipelineDefinitionDelete,
+		CustomizeDiff:        resourcePipelineDefinitionCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
-			"parameter_object": {
-				Type:     schema.TypeSet,
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"cancel_active": {
+				Type:     schema.TypeBool,
 				Optional: true,
+				Default:  true,
+			},
+			"definition_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: diffSuppressPipelineDefinitionJSON,
+				ConflictsWith:    []string{"pipeline_object", "parameter_object", "parameter_value"},
+				AtLeastOneOf:     []string{"definition_json", "pipeline_object"},
+			},
+			"parameter_object": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"definition_json"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"attribute": {
@@ -66,8 +89,9 @@ func ResourcePipelineDefinition() *schema.Resource {
 				},
 			},
 			"parameter_value": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"definition_json"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrID: {
@@ -90,8 +114,10 @@ func ResourcePipelineDefinition() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 1024),
 			},
 			"pipeline_object": {
-				Type:     schema.TypeSet,
-				Required: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"definition_json"},
+				AtLeastOneOf:  []string{"definition_json", "pipeline_object"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrField: {
@@ -130,6 +156,11 @@ func ResourcePipelineDefinition() *schema.Resource {
 					},
 				},
 			},
+			"start_timestamp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
 		},
 	}
 }
@@ -140,20 +171,19 @@ func resourcePipelineDefinitionPut(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.AWSClient).DataPipelineConn(ctx)
 
 	pipelineID := d.Get("pipeline_id").(string)
-	input := &datapipeline.PutPipelineDefinitionInput{
-		PipelineId:      aws.String(pipelineID),
-		PipelineObjects: expandPipelineDefinitionObjects(d.Get("pipeline_object").(*schema.Set).List()),
-	}
 
-	if v, ok := d.GetOk("parameter_object"); ok {
-		input.ParameterObjects = expandPipelineDefinitionParameterObjects(v.(*schema.Set).List())
+	pipelineObjects, parameterObjects, parameterValues, err := expandPipelineDefinitionFromResourceData(d)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing `definition_json` for DataPipeline Pipeline Definition (%s): %s", pipelineID, err)
 	}
 
-	if v, ok := d.GetOk("parameter_value"); ok {
-		input.ParameterValues = expandPipelineDefinitionParameterValues(v.(*schema.Set).List())
+	input := &datapipeline.PutPipelineDefinitionInput{
+		PipelineId:       aws.String(pipelineID),
+		PipelineObjects:  pipelineObjects,
+		ParameterObjects: parameterObjects,
+		ParameterValues:  parameterValues,
 	}
 
-	var err error
 	var output *datapipeline.PutPipelineDefinitionOutput
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		output, err = conn.PutPipelineDefinitionWithContext(ctx, input)
@@ -186,14 +216,35 @@ func resourcePipelineDefinitionPut(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "validating after creation DataPipeline Pipeline Definition (%s): %s", pipelineID, getValidationError(output.ValidationErrors))
 	}
 
-	// Activate pipeline if enabled
-	input2 := &datapipeline.ActivatePipelineInput{
-		PipelineId: aws.String(pipelineID),
-	}
+	if d.Get("active").(bool) {
+		activateInput := &datapipeline.ActivatePipelineInput{
+			PipelineId: aws.String(pipelineID),
+		}
 
-	_, err = conn.ActivatePipelineWithContext(ctx, input2)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "activating DataPipeline Pipeline Definition (%s): %s", pipelineID, err)
+		if v, ok := d.GetOk("start_timestamp"); ok {
+			t, err := time.Parse(time.RFC3339, v.(string))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "parsing `start_timestamp` for DataPipeline Pipeline Definition (%s): %s", pipelineID, err)
+			}
+			activateInput.StartTimestamp = aws.Time(t)
+		}
+
+		if len(parameterValues) > 0 {
+			activateInput.ParameterValues = parameterValues
+		}
+
+		_, err = conn.ActivatePipelineWithContext(ctx, activateInput)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "activating DataPipeline Pipeline Definition (%s): %s", pipelineID, err)
+		}
+	} else {
+		_, err = conn.DeactivatePipelineWithContext(ctx, &datapipeline.DeactivatePipelineInput{
+			PipelineId:   aws.String(pipelineID),
+			CancelActive: aws.Bool(d.Get("cancel_active").(bool)),
+		})
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deactivating DataPipeline Pipeline Definition (%s): %s", pipelineID, err)
+		}
 	}
 
 	d.SetId(pipelineID)
@@ -231,11 +282,167 @@ func resourcePipelineDefinitionRead(ctx context.Context, d *schema.ResourceData,
 	if err = d.Set("pipeline_object", flattenPipelineDefinitionObjects(resp.PipelineObjects)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting `%s` for DataPipeline Pipeline Definition (%s): %s", "parameter_object", d.Id(), err)
 	}
-	d.Set("pipeline_id", d.Id())
+
+	if v, ok := d.GetOk("definition_json"); ok && v.(string) != "" {
+		definitionJSON, err := flattenPipelineDefinitionJSON(resp.PipelineObjects, resp.ParameterObjects, resp.ParameterValues)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "rendering `definition_json` for DataPipeline Pipeline Definition (%s): %s", d.Id(), err)
+		}
+		if err = d.Set("definition_json", definitionJSON); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting `%s` for DataPipeline Pipeline Definition (%s): %s", "definition_json", d.Id(), err)
+		}
+	}
+
+	if err = d.Set("pipeline_id", d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting `%s` for DataPipeline Pipeline Definition (%s): %s", "pipeline_id", d.Id(), err)
+	}
+
+	pipelineState, err := findPipelineState(ctx, conn, d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DataPipeline Pipeline Definition (%s) state: %s", d.Id(), err)
+	}
+	if err = d.Set("active", pipelineState == pipelineStateScheduled); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting `%s` for DataPipeline Pipeline Definition (%s): %s", "active", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourcePipelineDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DataPipelineConn(ctx)
+
+	log.Printf("[DEBUG] Deactivating DataPipeline Pipeline Definition: %s", d.Id())
+	_, err := conn.DeactivatePipelineWithContext(ctx, &datapipeline.DeactivatePipelineInput{
+		PipelineId:   aws.String(d.Id()),
+		CancelActive: aws.Bool(d.Get("cancel_active").(bool)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, datapipeline.ErrCodePipelineNotFoundException) || tfawserr.ErrCodeEquals(err, datapipeline.ErrCodePipelineDeletedException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deactivating DataPipeline Pipeline Definition (%s): %s", d.Id(), err)
+	}
 
 	return diags
 }
 
+// pipelineStateScheduled is the value of the @pipelineState field DataPipeline
+// reports for an active pipeline; any other value (PENDING, FINISHED, ...)
+// means the pipeline is not currently running.
+const pipelineStateScheduled = "SCHEDULED"
+
+func findPipelineState(ctx context.Context, conn *datapipeline.DataPipeline, id string) (string, error) {
+	output, err := conn.DescribePipelinesWithContext(ctx, &datapipeline.DescribePipelinesInput{
+		PipelineIds: aws.StringSlice([]string{id}),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(output.PipelineDescriptionList) == 0 {
+		return "", nil
+	}
+
+	for _, field := range output.PipelineDescriptionList[0].Fields {
+		if aws.StringValue(field.Key) == "@pipelineState" {
+			return aws.StringValue(field.StringValue), nil
+		}
+	}
+
+	return "", nil
+}
+
+// resourcePipelineDefinitionCustomizeDiff runs the same structural validation
+// DataPipeline performs on PutPipelineDefinition at plan time, via
+// ValidatePipelineDefinition, so that malformed definitions surface before
+// any resource state changes rather than only on apply.
+//
+// schema.CustomizeDiffFunc can only return a fatal error, so there is no way
+// to surface ValidationWarnings as a non-fatal diagnostic the way a
+// diag.Diagnostics-returning CRUD function could; they are logged at WARN
+// instead, which is only visible with TF_LOG set. Revisit if the SDK ever
+// grows a diagnostics-capable CustomizeDiff signature.
+func resourcePipelineDefinitionCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	pipelineID := diff.Get("pipeline_id").(string)
+	if pipelineID == "" {
+		// pipeline_id is unknown (computed) at plan time; nothing to validate yet.
+		return nil
+	}
+
+	// The payload built below may depend on attributes of other resources
+	// (e.g. a field's string_value referencing an ARN that doesn't exist
+	// yet). If any of them aren't known until apply, diff.Get/GetOk would
+	// silently return zero-value data instead of the real value, producing
+	// bogus ValidationErrors. Skip plan-time validation in that case and let
+	// Put's own validation run once everything is resolved.
+	for _, key := range []string{"definition_json", "pipeline_object", "parameter_object", "parameter_value"} {
+		if !diff.GetRawConfig().GetAttr(key).IsWhollyKnown() {
+			return nil
+		}
+	}
+
+	pipelineObjects, parameterObjects, parameterValues, err := expandPipelineDefinitionFromResourceData(diff)
+	if err != nil {
+		return fmt.Errorf("parsing `definition_json`: %w", err)
+	}
+
+	conn := meta.(*conns.AWSClient).DataPipelineConn(ctx)
+	input := &datapipeline.ValidatePipelineDefinitionInput{
+		PipelineId:       aws.String(pipelineID),
+		PipelineObjects:  pipelineObjects,
+		ParameterObjects: parameterObjects,
+		ParameterValues:  parameterValues,
+	}
+
+	output, err := conn.ValidatePipelineDefinitionWithContext(ctx, input)
+	if err != nil {
+		return fmt.Errorf("validating DataPipeline Pipeline Definition (%s): %w", pipelineID, err)
+	}
+
+	if len(output.ValidationWarnings) > 0 {
+		log.Printf("[WARN] validating DataPipeline Pipeline Definition (%s): %s", pipelineID, getValidationWarning(output.ValidationWarnings))
+	}
+
+	if aws.BoolValue(output.Errored) {
+		return fmt.Errorf("validating DataPipeline Pipeline Definition (%s): %w", pipelineID, getValidationError(output.ValidationErrors))
+	}
+
+	return nil
+}
+
+// pipelineDefinitionGetter is satisfied by both *schema.ResourceData and
+// *schema.ResourceDiff, letting expandPipelineDefinitionFromResourceData back
+// both resourcePipelineDefinitionPut and resourcePipelineDefinitionCustomizeDiff
+// with the same payload-building logic.
+type pipelineDefinitionGetter interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+}
+
+func expandPipelineDefinitionFromResourceData(d pipelineDefinitionGetter) ([]*datapipeline.PipelineObject, []*datapipeline.ParameterObject, []*datapipeline.ParameterValue, error) {
+	if v, ok := d.GetOk("definition_json"); ok {
+		return expandPipelineDefinitionJSON(v.(string))
+	}
+
+	pipelineObjects := expandPipelineDefinitionObjects(d.Get("pipeline_object").(*schema.Set).List())
+
+	var parameterObjects []*datapipeline.ParameterObject
+	if v, ok := d.GetOk("parameter_object"); ok {
+		parameterObjects = expandPipelineDefinitionParameterObjects(v.(*schema.Set).List())
+	}
+
+	var parameterValues []*datapipeline.ParameterValue
+	if v, ok := d.GetOk("parameter_value"); ok {
+		parameterValues = expandPipelineDefinitionParameterValues(v.(*schema.Set).List())
+	}
+
+	return pipelineObjects, parameterObjects, parameterValues, nil
+}
+
 func expandPipelineDefinitionParameterObject(tfMap map[string]interface{}) *datapipeline.ParameterObject {
 	if tfMap == nil {
 		return nil
@@ -570,6 +777,300 @@ func flattenPipelineDefinitionObjects(apiObjects []*datapipeline.PipelineObject)
 	return tfList
 }
 
+// diffSuppressPipelineDefinitionJSON suppresses diffs between a config's
+// definition_json and the normalized form stored in state by round-tripping
+// both through the same expand/flatten pair used on read, so key order and
+// whitespace differences (e.g. a file exported from the AWS console or CLI)
+// don't show up as a perpetual plan diff.
+func diffSuppressPipelineDefinitionJSON(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return old == new
+	}
+
+	oldNormalized, err := normalizePipelineDefinitionJSON(old)
+	if err != nil {
+		return false
+	}
+
+	newNormalized, err := normalizePipelineDefinitionJSON(new)
+	if err != nil {
+		return false
+	}
+
+	return oldNormalized == newNormalized
+}
+
+func normalizePipelineDefinitionJSON(raw string) (string, error) {
+	pipelineObjects, parameterObjects, parameterValues, err := expandPipelineDefinitionJSON(raw)
+	if err != nil {
+		return "", err
+	}
+
+	return flattenPipelineDefinitionJSON(pipelineObjects, parameterObjects, parameterValues)
+}
+
+// pipelineDefinitionJSON is the shape of the JSON document produced by
+// `aws datapipeline get-pipeline-definition`: a list of objects, parameters,
+// and parameter values, each keyed by "id". An object's remaining fields hold
+// either a literal string or a `{"ref": "..."}` reference to another object;
+// a parameter's remaining fields are string attributes, and a value holds its
+// string under "stringValue".
+type pipelineDefinitionJSON struct {
+	Objects    []map[string]interface{} `json:"objects"`
+	Parameters []map[string]interface{} `json:"parameters"`
+	Values     []map[string]interface{} `json:"values"`
+}
+
+func expandPipelineDefinitionJSON(raw string) ([]*datapipeline.PipelineObject, []*datapipeline.ParameterObject, []*datapipeline.ParameterValue, error) {
+	var doc pipelineDefinitionJSON
+
+	if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+		return nil, nil, nil, err
+	}
+
+	pipelineObjects, err := expandPipelineDefinitionJSONObjects(doc.Objects)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	parameterObjects, err := expandPipelineDefinitionJSONParameterObjects(doc.Parameters)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	parameterValues, err := expandPipelineDefinitionJSONParameterValues(doc.Values)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return pipelineObjects, parameterObjects, parameterValues, nil
+}
+
+func expandPipelineDefinitionJSONObjects(raw []map[string]interface{}) ([]*datapipeline.PipelineObject, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	apiObjects := make([]*datapipeline.PipelineObject, 0, len(raw))
+
+	for _, tfMap := range raw {
+		id, ok := tfMap[names.AttrID].(string)
+		if !ok || id == "" {
+			return nil, errors.New(`object is missing required "id"`)
+		}
+
+		name, ok := tfMap[names.AttrName].(string)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("object %q is missing required %q", id, names.AttrName)
+		}
+
+		fields, err := expandPipelineDefinitionJSONFields(tfMap)
+		if err != nil {
+			return nil, fmt.Errorf("object %q: %w", id, err)
+		}
+
+		apiObjects = append(apiObjects, &datapipeline.PipelineObject{
+			Id:     aws.String(id),
+			Name:   aws.String(name),
+			Fields: fields,
+		})
+	}
+
+	return apiObjects, nil
+}
+
+// expandPipelineDefinitionJSONFields walks every key of an object other than
+// "id" and "name", emitting one datapipeline.Field per key in sorted order so
+// the resulting PipelineObjects are deterministic.
+func expandPipelineDefinitionJSONFields(tfMap map[string]interface{}) ([]*datapipeline.Field, error) {
+	keys := make([]string, 0, len(tfMap))
+	for k := range tfMap {
+		if k == names.AttrID || k == names.AttrName {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var apiObjects []*datapipeline.Field
+
+	for _, key := range keys {
+		field, err := expandPipelineDefinitionJSONField(key, tfMap[key])
+		if err != nil {
+			return nil, err
+		}
+
+		apiObjects = append(apiObjects, field)
+	}
+
+	return apiObjects, nil
+}
+
+func expandPipelineDefinitionJSONField(key string, raw interface{}) (*datapipeline.Field, error) {
+	apiObject := &datapipeline.Field{
+		Key: aws.String(key),
+	}
+
+	switch v := raw.(type) {
+	case map[string]interface{}:
+		ref, ok := v["ref"].(string)
+		if !ok {
+			return nil, fmt.Errorf(`field %q: expected a string or {"ref": "..."}`, key)
+		}
+		apiObject.RefValue = aws.String(ref)
+	case string:
+		apiObject.StringValue = aws.String(v)
+	default:
+		return nil, fmt.Errorf(`field %q: expected a string or {"ref": "..."}`, key)
+	}
+
+	return apiObject, nil
+}
+
+func expandPipelineDefinitionJSONParameterObjects(raw []map[string]interface{}) ([]*datapipeline.ParameterObject, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	apiObjects := make([]*datapipeline.ParameterObject, 0, len(raw))
+
+	for _, tfMap := range raw {
+		id, ok := tfMap[names.AttrID].(string)
+		if !ok || id == "" {
+			return nil, errors.New(`parameter is missing required "id"`)
+		}
+
+		keys := make([]string, 0, len(tfMap))
+		for k := range tfMap {
+			if k == names.AttrID {
+				continue
+			}
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var attributes []*datapipeline.ParameterAttribute
+		for _, key := range keys {
+			v, ok := tfMap[key].(string)
+			if !ok {
+				return nil, fmt.Errorf("parameter %q: attribute %q: expected a string", id, key)
+			}
+
+			attributes = append(attributes, &datapipeline.ParameterAttribute{
+				Key:         aws.String(key),
+				StringValue: aws.String(v),
+			})
+		}
+
+		apiObjects = append(apiObjects, &datapipeline.ParameterObject{
+			Id:         aws.String(id),
+			Attributes: attributes,
+		})
+	}
+
+	return apiObjects, nil
+}
+
+func expandPipelineDefinitionJSONParameterValues(raw []map[string]interface{}) ([]*datapipeline.ParameterValue, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	apiObjects := make([]*datapipeline.ParameterValue, 0, len(raw))
+
+	for _, tfMap := range raw {
+		id, ok := tfMap[names.AttrID].(string)
+		if !ok || id == "" {
+			return nil, errors.New(`value is missing required "id"`)
+		}
+
+		stringValue, ok := tfMap["stringValue"].(string)
+		if !ok {
+			return nil, fmt.Errorf("value %q is missing required \"stringValue\"", id)
+		}
+
+		apiObjects = append(apiObjects, &datapipeline.ParameterValue{
+			Id:          aws.String(id),
+			StringValue: aws.String(stringValue),
+		})
+	}
+
+	return apiObjects, nil
+}
+
+// flattenPipelineDefinitionJSON renders the pipeline's current server-side
+// definition back into the same JSON document shape definition_json accepts.
+// Object keys are sorted by encoding/json's default map handling and objects
+// are sorted by id, so repeated reads of an unchanged pipeline produce byte
+// identical output and the plan stays stable.
+func flattenPipelineDefinitionJSON(pipelineObjects []*datapipeline.PipelineObject, parameterObjects []*datapipeline.ParameterObject, parameterValues []*datapipeline.ParameterValue) (string, error) {
+	doc := pipelineDefinitionJSON{}
+
+	for _, apiObject := range pipelineObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrID:   aws.StringValue(apiObject.Id),
+			names.AttrName: aws.StringValue(apiObject.Name),
+		}
+
+		for _, field := range apiObject.Fields {
+			if field.RefValue != nil {
+				tfMap[aws.StringValue(field.Key)] = map[string]interface{}{"ref": aws.StringValue(field.RefValue)}
+			} else {
+				tfMap[aws.StringValue(field.Key)] = aws.StringValue(field.StringValue)
+			}
+		}
+
+		doc.Objects = append(doc.Objects, tfMap)
+	}
+	sort.Slice(doc.Objects, func(i, j int) bool {
+		return doc.Objects[i][names.AttrID].(string) < doc.Objects[j][names.AttrID].(string)
+	})
+
+	for _, apiObject := range parameterObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrID: aws.StringValue(apiObject.Id),
+		}
+
+		for _, attribute := range apiObject.Attributes {
+			tfMap[aws.StringValue(attribute.Key)] = aws.StringValue(attribute.StringValue)
+		}
+
+		doc.Parameters = append(doc.Parameters, tfMap)
+	}
+	sort.Slice(doc.Parameters, func(i, j int) bool {
+		return doc.Parameters[i][names.AttrID].(string) < doc.Parameters[j][names.AttrID].(string)
+	})
+
+	for _, apiObject := range parameterValues {
+		if apiObject == nil {
+			continue
+		}
+
+		doc.Values = append(doc.Values, map[string]interface{}{
+			names.AttrID:  aws.StringValue(apiObject.Id),
+			"stringValue": aws.StringValue(apiObject.StringValue),
+		})
+	}
+	sort.Slice(doc.Values, func(i, j int) bool {
+		return doc.Values[i][names.AttrID].(string) < doc.Values[j][names.AttrID].(string)
+	})
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
 func getValidationError(validationErrors []*datapipeline.ValidationError) error {
 	var errs []error
 
@@ -579,3 +1080,13 @@ func getValidationError(validationErrors []*datapipeline.ValidationError) error
 
 	return errors.Join(errs...)
 }
+
+func getValidationWarning(validationWarnings []*datapipeline.ValidationWarning) error {
+	var errs []error
+
+	for _, warning := range validationWarnings {
+		errs = append(errs, fmt.Errorf("id: %s, warning: %v", aws.StringValue(warning.Id), aws.StringValueSlice(warning.Warnings)))
+	}
+
+	return errors.Join(errs...)
+}
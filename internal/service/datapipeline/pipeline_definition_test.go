@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datapipeline
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+)
+
+func TestExpandPipelineDefinitionJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input          string
+		wantErr        bool
+		wantObjects    []*datapipeline.PipelineObject
+		wantParameters []*datapipeline.ParameterObject
+		wantValues     []*datapipeline.ParameterValue
+	}{
+		"literal and ref fields": {
+			input: `{
+				"objects": [
+					{"id": "Default", "name": "Default", "type": "Default"},
+					{"id": "S3Output", "name": "S3Output", "type": "S3DataNode", "dependsOn": {"ref": "Default"}}
+				]
+			}`,
+			wantObjects: []*datapipeline.PipelineObject{
+				{
+					Id:   aws.String("Default"),
+					Name: aws.String("Default"),
+					Fields: []*datapipeline.Field{
+						{Key: aws.String("type"), StringValue: aws.String("Default")},
+					},
+				},
+				{
+					Id:   aws.String("S3Output"),
+					Name: aws.String("S3Output"),
+					Fields: []*datapipeline.Field{
+						{Key: aws.String("dependsOn"), RefValue: aws.String("Default")},
+						{Key: aws.String("type"), StringValue: aws.String("S3DataNode")},
+					},
+				},
+			},
+		},
+		"parameters and values": {
+			input: `{
+				"objects": [{"id": "Default", "name": "Default"}],
+				"parameters": [{"id": "myShellCmd", "type": "String", "default": "echo hello"}],
+				"values": [{"id": "myShellCmd", "stringValue": "echo world"}]
+			}`,
+			wantObjects: []*datapipeline.PipelineObject{
+				{Id: aws.String("Default"), Name: aws.String("Default")},
+			},
+			wantParameters: []*datapipeline.ParameterObject{
+				{
+					Id: aws.String("myShellCmd"),
+					Attributes: []*datapipeline.ParameterAttribute{
+						{Key: aws.String("default"), StringValue: aws.String("echo hello")},
+						{Key: aws.String("type"), StringValue: aws.String("String")},
+					},
+				},
+			},
+			wantValues: []*datapipeline.ParameterValue{
+				{Id: aws.String("myShellCmd"), StringValue: aws.String("echo world")},
+			},
+		},
+		"invalid JSON": {
+			input:   `not json`,
+			wantErr: true,
+		},
+		"object missing id": {
+			input:   `{"objects": [{"name": "Default"}]}`,
+			wantErr: true,
+		},
+		"object missing name": {
+			input:   `{"objects": [{"id": "Default"}]}`,
+			wantErr: true,
+		},
+		"field neither string nor ref": {
+			input:   `{"objects": [{"id": "Default", "name": "Default", "bad": {"notRef": "x"}}]}`,
+			wantErr: true,
+		},
+		"field is not a string": {
+			input:   `{"objects": [{"id": "Default", "name": "Default", "attemptTimeout": 30}]}`,
+			wantErr: true,
+		},
+		"parameter missing id": {
+			input:   `{"parameters": [{"type": "String"}]}`,
+			wantErr: true,
+		},
+		"parameter attribute not a string": {
+			input:   `{"parameters": [{"id": "myParam", "type": {"nested": "object"}}]}`,
+			wantErr: true,
+		},
+		"value missing stringValue": {
+			input:   `{"values": [{"id": "myParam"}]}`,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotObjects, gotParameters, gotValues, err := expandPipelineDefinitionJSON(tc.input)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmpPipelineObjects(gotObjects, tc.wantObjects); diff != "" {
+				t.Errorf("unexpected pipeline objects: %s", diff)
+			}
+			if diff := cmpParameterObjects(gotParameters, tc.wantParameters); diff != "" {
+				t.Errorf("unexpected parameter objects: %s", diff)
+			}
+			if diff := cmpParameterValues(gotValues, tc.wantValues); diff != "" {
+				t.Errorf("unexpected parameter values: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenPipelineDefinitionJSON(t *testing.T) {
+	t.Parallel()
+
+	pipelineObjects := []*datapipeline.PipelineObject{
+		{
+			Id:   aws.String("S3Output"),
+			Name: aws.String("S3Output"),
+			Fields: []*datapipeline.Field{
+				{Key: aws.String("dependsOn"), RefValue: aws.String("Default")},
+				{Key: aws.String("type"), StringValue: aws.String("S3DataNode")},
+			},
+		},
+		{
+			Id:   aws.String("Default"),
+			Name: aws.String("Default"),
+			Fields: []*datapipeline.Field{
+				{Key: aws.String("type"), StringValue: aws.String("Default")},
+			},
+		},
+	}
+	parameterObjects := []*datapipeline.ParameterObject{
+		{
+			Id: aws.String("myShellCmd"),
+			Attributes: []*datapipeline.ParameterAttribute{
+				{Key: aws.String("type"), StringValue: aws.String("String")},
+			},
+		},
+	}
+	parameterValues := []*datapipeline.ParameterValue{
+		{Id: aws.String("myShellCmd"), StringValue: aws.String("echo hello")},
+	}
+
+	got, err := flattenPipelineDefinitionJSON(pipelineObjects, parameterObjects, parameterValues)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"objects":[{"id":"Default","name":"Default","type":"Default"},{"id":"S3Output","dependsOn":{"ref":"Default"},"name":"S3Output","type":"S3DataNode"}],"parameters":[{"id":"myShellCmd","type":"String"}],"values":[{"id":"myShellCmd","stringValue":"echo hello"}]}`
+
+	// encoding/json sorts map keys alphabetically, so compare the objects
+	// round-tripped through expand rather than the raw bytes, which would
+	// otherwise be brittle to field ordering within each object.
+	gotObjects, gotParameters, gotValues, err := expandPipelineDefinitionJSON(got)
+	if err != nil {
+		t.Fatalf("re-parsing flattened output: %s", err)
+	}
+	wantObjects, wantParameters, wantValues, err := expandPipelineDefinitionJSON(want)
+	if err != nil {
+		t.Fatalf("parsing expected output: %s", err)
+	}
+
+	if diff := cmpPipelineObjects(gotObjects, wantObjects); diff != "" {
+		t.Errorf("unexpected pipeline objects: %s", diff)
+	}
+	if diff := cmpParameterObjects(gotParameters, wantParameters); diff != "" {
+		t.Errorf("unexpected parameter objects: %s", diff)
+	}
+	if diff := cmpParameterValues(gotValues, wantValues); diff != "" {
+		t.Errorf("unexpected parameter values: %s", diff)
+	}
+
+	// Flattening twice from the same API response must be byte-identical so
+	// that a DiffSuppressFunc comparing normalized forms is stable.
+	got2, err := flattenPipelineDefinitionJSON(pipelineObjects, parameterObjects, parameterValues)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != got2 {
+		t.Errorf("flatten is not deterministic: %q != %q", got, got2)
+	}
+}
+
+func cmpPipelineObjects(got, want []*datapipeline.PipelineObject) string {
+	if len(got) != len(want) {
+		return fieldMismatch("length", len(got), len(want))
+	}
+
+	for i := range want {
+		if aws.StringValue(got[i].Id) != aws.StringValue(want[i].Id) {
+			return fieldMismatch("id", aws.StringValue(got[i].Id), aws.StringValue(want[i].Id))
+		}
+		if aws.StringValue(got[i].Name) != aws.StringValue(want[i].Name) {
+			return fieldMismatch("name", aws.StringValue(got[i].Name), aws.StringValue(want[i].Name))
+		}
+		if diff := cmpFields(got[i].Fields, want[i].Fields); diff != "" {
+			return diff
+		}
+	}
+
+	return ""
+}
+
+func cmpFields(got, want []*datapipeline.Field) string {
+	if len(got) != len(want) {
+		return fieldMismatch("field count", len(got), len(want))
+	}
+
+	for i := range want {
+		if aws.StringValue(got[i].Key) != aws.StringValue(want[i].Key) {
+			return fieldMismatch("field key", aws.StringValue(got[i].Key), aws.StringValue(want[i].Key))
+		}
+		if aws.StringValue(got[i].RefValue) != aws.StringValue(want[i].RefValue) {
+			return fieldMismatch("field ref_value", aws.StringValue(got[i].RefValue), aws.StringValue(want[i].RefValue))
+		}
+		if aws.StringValue(got[i].StringValue) != aws.StringValue(want[i].StringValue) {
+			return fieldMismatch("field string_value", aws.StringValue(got[i].StringValue), aws.StringValue(want[i].StringValue))
+		}
+	}
+
+	return ""
+}
+
+func cmpParameterObjects(got, want []*datapipeline.ParameterObject) string {
+	if len(got) != len(want) {
+		return fieldMismatch("length", len(got), len(want))
+	}
+
+	for i := range want {
+		if aws.StringValue(got[i].Id) != aws.StringValue(want[i].Id) {
+			return fieldMismatch("id", aws.StringValue(got[i].Id), aws.StringValue(want[i].Id))
+		}
+		if len(got[i].Attributes) != len(want[i].Attributes) {
+			return fieldMismatch("attribute count", len(got[i].Attributes), len(want[i].Attributes))
+		}
+		for j := range want[i].Attributes {
+			if aws.StringValue(got[i].Attributes[j].Key) != aws.StringValue(want[i].Attributes[j].Key) {
+				return fieldMismatch("attribute key", aws.StringValue(got[i].Attributes[j].Key), aws.StringValue(want[i].Attributes[j].Key))
+			}
+			if aws.StringValue(got[i].Attributes[j].StringValue) != aws.StringValue(want[i].Attributes[j].StringValue) {
+				return fieldMismatch("attribute string_value", aws.StringValue(got[i].Attributes[j].StringValue), aws.StringValue(want[i].Attributes[j].StringValue))
+			}
+		}
+	}
+
+	return ""
+}
+
+func cmpParameterValues(got, want []*datapipeline.ParameterValue) string {
+	if len(got) != len(want) {
+		return fieldMismatch("length", len(got), len(want))
+	}
+
+	for i := range want {
+		if aws.StringValue(got[i].Id) != aws.StringValue(want[i].Id) {
+			return fieldMismatch("id", aws.StringValue(got[i].Id), aws.StringValue(want[i].Id))
+		}
+		if aws.StringValue(got[i].StringValue) != aws.StringValue(want[i].StringValue) {
+			return fieldMismatch("string_value", aws.StringValue(got[i].StringValue), aws.StringValue(want[i].StringValue))
+		}
+	}
+
+	return ""
+}
+
+func fieldMismatch(field string, got, want interface{}) string {
+	return fmt.Sprintf("mismatched %s: got %v, want %v", field, got, want)
+}